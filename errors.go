@@ -0,0 +1,273 @@
+package semver
+
+import "fmt"
+
+// ErrorKind enumerates the reasons a ParseError can be raised for, letting
+// callers programmatically distinguish "not semver at all" from more
+// specific failures like a leading zero in a numeric component.
+type ErrorKind int
+
+const (
+	// ErrRegexMismatch means the input does not match the semver grammar
+	// and no more specific reason could be determined.
+	ErrRegexMismatch ErrorKind = iota
+	// ErrLeadingZero means a numeric component or identifier contains a
+	// disallowed leading zero (e.g. "01.2.3" or "1.2.3-01").
+	ErrLeadingZero
+	// ErrEmptyIdentifier means a required component or dot-separated
+	// identifier was empty (e.g. "1.2.3-" or "1..3").
+	ErrEmptyIdentifier
+	// ErrInvalidChar means a component or identifier contains a character
+	// that is not allowed there.
+	ErrInvalidChar
+	// ErrOverflow means a numeric component's digits matched the grammar
+	// but the value is too large to fit a uint64/int64.
+	ErrOverflow
+)
+
+// String returns a short human-readable description of the ErrorKind.
+func (k ErrorKind) String() string {
+	switch k {
+	case ErrLeadingZero:
+		return "leading zero not allowed"
+	case ErrEmptyIdentifier:
+		return "identifier must not be empty"
+	case ErrInvalidChar:
+		return "invalid character"
+	case ErrOverflow:
+		return "value out of range"
+	default:
+		return "does not match semver grammar"
+	}
+}
+
+// ParseError reports why Parse, ParseTolerant, or NewPRVersion rejected a
+// version string, including the offending component and its byte offset
+// within Input.
+type ParseError struct {
+	// Input is the original string that failed to parse.
+	Input string
+	// Position is the byte offset within Input where the failure was
+	// detected.
+	Position int
+	// Component names the part of the version being parsed: one of
+	// "major", "minor", "patch", "revision", "prerelease", or "build".
+	Component string
+	// Reason categorizes why the component failed to parse.
+	Reason ErrorKind
+}
+
+// Error implements the error interface.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("invalid version %q: %s %s at position %d", e.Input, e.Component, e.Reason, e.Position)
+}
+
+// Is reports whether target is a *ParseError with the same Reason,
+// enabling errors.Is(err, &ParseError{Reason: ErrLeadingZero}) style
+// checks regardless of Input, Position, or Component.
+func (e *ParseError) Is(target error) bool {
+	t, ok := target.(*ParseError)
+	if !ok {
+		return false
+	}
+	return e.Reason == t.Reason
+}
+
+func isDigitByte(b byte) bool {
+	return b >= '0' && b <= '9'
+}
+
+func isIdentifierByte(b byte) bool {
+	return isDigitByte(b) || (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z') || b == '-'
+}
+
+func isAllDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isDigitByte(s[i]) {
+			return false
+		}
+	}
+	return len(s) > 0
+}
+
+// scanNumericComponent scans a run of digits at pos, validating that it is
+// non-empty and free of leading zeroes.
+func scanNumericComponent(s string, pos int, component string) (int, *ParseError) {
+	start := pos
+	for pos < len(s) && isDigitByte(s[pos]) {
+		pos++
+	}
+	if pos == start {
+		if pos == len(s) || s[pos] == '.' {
+			return pos, &ParseError{Input: s, Position: pos, Component: component, Reason: ErrEmptyIdentifier}
+		}
+		return pos, &ParseError{Input: s, Position: pos, Component: component, Reason: ErrInvalidChar}
+	}
+	if pos-start > 1 && s[start] == '0' {
+		return pos, &ParseError{Input: s, Position: start, Component: component, Reason: ErrLeadingZero}
+	}
+	return pos, nil
+}
+
+// scanIdentifierList scans a dot-separated list of identifiers starting at
+// pos, stopping at the first character that cannot continue the list.
+// Purely numeric identifiers are checked for leading zeroes when
+// checkNumericLeadingZero is set (prerelease identifiers forbid them,
+// build identifiers do not).
+func scanIdentifierList(s string, pos int, component string, checkNumericLeadingZero bool) (int, *ParseError) {
+	for {
+		start := pos
+		for pos < len(s) && isIdentifierByte(s[pos]) {
+			pos++
+		}
+		if pos == start {
+			return pos, &ParseError{Input: s, Position: pos, Component: component, Reason: ErrEmptyIdentifier}
+		}
+		ident := s[start:pos]
+		if checkNumericLeadingZero && len(ident) > 1 && ident[0] == '0' && isAllDigits(ident) {
+			return pos, &ParseError{Input: s, Position: start, Component: component, Reason: ErrLeadingZero}
+		}
+		if pos < len(s) && s[pos] == '.' {
+			pos++
+			continue
+		}
+		return pos, nil
+	}
+}
+
+// diagnoseParseError re-scans s by hand to determine exactly which
+// component of the semver grammar it failed on, and why. It is only called
+// once KongVersionRegex has already rejected s, so it trades the regex's
+// speed for a scanner that can report accurate offsets.
+func diagnoseParseError(s string) *ParseError {
+	pos, perr := scanNumericComponent(s, 0, "major")
+	if perr != nil {
+		return perr
+	}
+
+	if pos >= len(s) || s[pos] != '.' {
+		return &ParseError{Input: s, Position: pos, Component: "minor", Reason: ErrInvalidChar}
+	}
+	pos++
+
+	pos, perr = scanNumericComponent(s, pos, "minor")
+	if perr != nil {
+		return perr
+	}
+
+	if pos >= len(s) || s[pos] != '.' {
+		return &ParseError{Input: s, Position: pos, Component: "patch", Reason: ErrInvalidChar}
+	}
+	pos++
+
+	pos, perr = scanNumericComponent(s, pos, "patch")
+	if perr != nil {
+		return perr
+	}
+
+	if pos < len(s) && s[pos] == '.' && pos+1 < len(s) && isDigitByte(s[pos+1]) {
+		pos, perr = scanNumericComponent(s, pos+1, "revision")
+		if perr != nil {
+			return perr
+		}
+	}
+
+	if pos < len(s) && s[pos] == '-' {
+		pos, perr = scanIdentifierList(s, pos+1, "prerelease", true)
+		if perr != nil {
+			return perr
+		}
+	}
+
+	if pos < len(s) && s[pos] == '+' {
+		pos, perr = scanIdentifierList(s, pos+1, "build", false)
+		if perr != nil {
+			return perr
+		}
+	}
+
+	if pos != len(s) {
+		return &ParseError{Input: s, Position: pos, Component: "build", Reason: ErrInvalidChar}
+	}
+
+	// The hand-written scanner accepts a superset of the regex grammar
+	// (it does not enforce ordering subtleties the regex encodes); fall
+	// back to a generic mismatch if it found no concrete problem.
+	return &ParseError{Input: s, Position: 0, Component: "major", Reason: ErrRegexMismatch}
+}
+
+// diagnoseTolerantMismatch re-scans s by hand, mirroring the tolerant
+// grammar's leniency (optional "v" prefix, optional components, optional
+// surrounding whitespace), to approximate which component and byte offset
+// KongTolerantVersionRegex failed to match on. It is only called once that
+// regex has already rejected s.
+func diagnoseTolerantMismatch(s string) *ParseError {
+	pos := 0
+	for pos < len(s) && (s[pos] == ' ' || s[pos] == '\t') {
+		pos++
+	}
+	if pos < len(s) && (s[pos] == 'v' || s[pos] == 'V') {
+		pos++
+	}
+
+	start := pos
+	for pos < len(s) && isDigitByte(s[pos]) {
+		pos++
+	}
+	if pos == start {
+		return &ParseError{Input: s, Position: pos, Component: "major", Reason: ErrRegexMismatch}
+	}
+
+	if pos < len(s) && s[pos] == '.' {
+		pos++
+		start = pos
+		for pos < len(s) && isDigitByte(s[pos]) {
+			pos++
+		}
+		if pos == start {
+			return &ParseError{Input: s, Position: pos, Component: "minor", Reason: ErrRegexMismatch}
+		}
+	}
+
+	if pos < len(s) && s[pos] == '.' {
+		pos++
+		start = pos
+		for pos < len(s) && isDigitByte(s[pos]) {
+			pos++
+		}
+		if pos == start {
+			return &ParseError{Input: s, Position: pos, Component: "patch", Reason: ErrRegexMismatch}
+		}
+	}
+
+	if pos < len(s) && s[pos] == '.' && pos+1 < len(s) && isDigitByte(s[pos+1]) {
+		pos++
+		for pos < len(s) && isDigitByte(s[pos]) {
+			pos++
+		}
+	}
+
+	if pos < len(s) && s[pos] == '-' {
+		pos++
+		start = pos
+		for pos < len(s) && isIdentifierByte(s[pos]) {
+			pos++
+		}
+		if pos == start {
+			return &ParseError{Input: s, Position: pos, Component: "prerelease", Reason: ErrEmptyIdentifier}
+		}
+	}
+
+	if pos < len(s) && s[pos] == '+' {
+		pos++
+		start = pos
+		for pos < len(s) && isIdentifierByte(s[pos]) {
+			pos++
+		}
+		if pos == start {
+			return &ParseError{Input: s, Position: pos, Component: "build", Reason: ErrEmptyIdentifier}
+		}
+	}
+
+	return &ParseError{Input: s, Position: pos, Component: "major", Reason: ErrInvalidChar}
+}
@@ -0,0 +1,135 @@
+package semver
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseErrorLeadingZero(t *testing.T) {
+	_, err := Parse("01.2.3")
+	if err == nil {
+		t.Fatalf("expected Parse to return an error")
+	}
+
+	var pe *ParseError
+	if !errors.As(err, &pe) {
+		t.Fatalf("expected a *ParseError, got %T", err)
+	}
+	if pe.Component != "major" {
+		t.Errorf("Component = %q, want %q", pe.Component, "major")
+	}
+	if pe.Reason != ErrLeadingZero {
+		t.Errorf("Reason = %v, want %v", pe.Reason, ErrLeadingZero)
+	}
+	if pe.Position != 0 {
+		t.Errorf("Position = %d, want 0", pe.Position)
+	}
+}
+
+func TestParseErrorEmptyPrerelease(t *testing.T) {
+	_, err := Parse("1.2.3-")
+	if err == nil {
+		t.Fatalf("expected Parse to return an error")
+	}
+
+	var pe *ParseError
+	if !errors.As(err, &pe) {
+		t.Fatalf("expected a *ParseError, got %T", err)
+	}
+	if pe.Component != "prerelease" {
+		t.Errorf("Component = %q, want %q", pe.Component, "prerelease")
+	}
+	if pe.Reason != ErrEmptyIdentifier {
+		t.Errorf("Reason = %v, want %v", pe.Reason, ErrEmptyIdentifier)
+	}
+}
+
+func TestParseErrorTrailingGarbage(t *testing.T) {
+	_, err := Parse("1.2.3-beta!")
+	if err == nil {
+		t.Fatalf("expected Parse to return an error")
+	}
+
+	var pe *ParseError
+	if !errors.As(err, &pe) {
+		t.Fatalf("expected a *ParseError, got %T", err)
+	}
+	if pe.Reason != ErrInvalidChar {
+		t.Errorf("Reason = %v, want %v", pe.Reason, ErrInvalidChar)
+	}
+	if pe.Position != len("1.2.3-beta") {
+		t.Errorf("Position = %d, want %d", pe.Position, len("1.2.3-beta"))
+	}
+}
+
+func TestParseErrorIsMatchesByReason(t *testing.T) {
+	_, err := Parse("01.2.3")
+	if !errors.Is(err, &ParseError{Reason: ErrLeadingZero}) {
+		t.Errorf("expected errors.Is to match on Reason alone")
+	}
+	if errors.Is(err, &ParseError{Reason: ErrInvalidChar}) {
+		t.Errorf("expected errors.Is to not match a different Reason")
+	}
+}
+
+func TestParseErrorOverflow(t *testing.T) {
+	_, err := Parse("99999999999999999999.0.0")
+	if err == nil {
+		t.Fatalf("expected Parse to return an error")
+	}
+
+	var pe *ParseError
+	if !errors.As(err, &pe) {
+		t.Fatalf("expected a *ParseError, got %T", err)
+	}
+	if pe.Component != "major" {
+		t.Errorf("Component = %q, want %q", pe.Component, "major")
+	}
+	if pe.Reason != ErrOverflow {
+		t.Errorf("Reason = %v, want %v", pe.Reason, ErrOverflow)
+	}
+	if pe.Position != 0 {
+		t.Errorf("Position = %d, want %d", pe.Position, 0)
+	}
+}
+
+func TestParseErrorOverflowReportsComponentPosition(t *testing.T) {
+	input := "1.2.99999999999999999999"
+	_, err := Parse(input)
+	if err == nil {
+		t.Fatalf("expected Parse to return an error")
+	}
+
+	var pe *ParseError
+	if !errors.As(err, &pe) {
+		t.Fatalf("expected a *ParseError, got %T", err)
+	}
+	if pe.Component != "patch" {
+		t.Errorf("Component = %q, want %q", pe.Component, "patch")
+	}
+	if pe.Reason != ErrOverflow {
+		t.Errorf("Reason = %v, want %v", pe.Reason, ErrOverflow)
+	}
+	if want := strings.Index(input, "99999999999999999999"); pe.Position != want {
+		t.Errorf("Position = %d, want %d", pe.Position, want)
+	}
+}
+
+func TestNewPRVersionErrors(t *testing.T) {
+	_, err := NewPRVersion("")
+	var pe *ParseError
+	if !errors.As(err, &pe) || pe.Reason != ErrEmptyIdentifier {
+		t.Errorf("expected NewPRVersion(\"\") to return ErrEmptyIdentifier, got %v", err)
+	}
+
+	_, err = NewPRVersion("01")
+	if !errors.As(err, &pe) || pe.Reason != ErrLeadingZero {
+		t.Errorf("expected NewPRVersion(\"01\") to return ErrLeadingZero, got %v", err)
+	}
+
+	_, err = NewPRVersion("bad!char")
+	if !errors.As(err, &pe) || pe.Reason != ErrInvalidChar {
+		t.Errorf("expected NewPRVersion(\"bad!char\") to return ErrInvalidChar, got %v", err)
+	}
+}
@@ -0,0 +1,195 @@
+package semver
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var genericVersionRegex = regexp.MustCompile(
+	`^([vV])?([0-9]+(?:\.[0-9]+)*)` +
+		`(?:-([0-9A-Za-z.-]+))?` +
+		`(?:\+([0-9A-Za-z.-]+))?$`)
+
+// GenericVersion represents a version with an arbitrary number of numeric
+// components, following the approach used by Kubernetes' util/version. It
+// retains the exact component count it was parsed with, but compares
+// component-by-component treating missing trailing components as zero, so
+// "1.2" == "1.2.0".
+type GenericVersion struct {
+	components []uint64
+	pre        []PRVersion
+	build      []string
+}
+
+// ParseGeneric parses s as a GenericVersion, tolerating a leading "v"/"V"
+// and any number of dot-separated numeric components.
+func ParseGeneric(s string) (GenericVersion, error) {
+	return parseGeneric(s, false)
+}
+
+// ParseGenericStrict parses s as a GenericVersion, rejecting a leading
+// "v"/"V" prefix and leading zeroes in any numeric component.
+func ParseGenericStrict(s string) (GenericVersion, error) {
+	return parseGeneric(s, true)
+}
+
+func parseGeneric(s string, strict bool) (GenericVersion, error) {
+	trimmed := strings.TrimSpace(s)
+	if len(trimmed) == 0 {
+		return GenericVersion{}, fmt.Errorf("Invalid generic version: '%s'", s)
+	}
+
+	m := genericVersionRegex.FindStringSubmatch(trimmed)
+	if m == nil {
+		return GenericVersion{}, fmt.Errorf("Invalid generic version: '%s'", s)
+	}
+	vPrefix, componentsStr, preStr, buildStr := m[1], m[2], m[3], m[4]
+	if strict && len(vPrefix) > 0 {
+		return GenericVersion{}, fmt.Errorf("Invalid generic version: '%s' (strict mode does not allow a 'v' prefix)", s)
+	}
+
+	parts := strings.Split(componentsStr, ".")
+	components := make([]uint64, 0, len(parts))
+	for _, p := range parts {
+		if strict && hasLeadingZeroes(p) {
+			return GenericVersion{}, fmt.Errorf("Invalid generic version: '%s' (leading zeroes not allowed in strict mode)", s)
+		}
+		n, err := strconv.ParseUint(p, 10, 64)
+		if err != nil {
+			return GenericVersion{}, err
+		}
+		components = append(components, n)
+	}
+
+	var pre []PRVersion
+	if len(preStr) > 0 {
+		for _, p := range strings.Split(preStr, ".") {
+			prv, err := NewPRVersion(p)
+			if err != nil {
+				return GenericVersion{}, err
+			}
+			pre = append(pre, prv)
+		}
+	}
+
+	var build []string
+	if len(buildStr) > 0 {
+		build = strings.Split(buildStr, ".")
+	}
+
+	return GenericVersion{components: components, pre: pre, build: build}, nil
+}
+
+// WithComponents returns a GenericVersion built directly from the given
+// numeric components, with no prerelease or build metadata.
+func WithComponents(components ...uint64) GenericVersion {
+	return GenericVersion{components: append([]uint64(nil), components...)}
+}
+
+// Components returns the parsed numeric components, in order.
+func (g GenericVersion) Components() []uint64 {
+	return append([]uint64(nil), g.components...)
+}
+
+func (g GenericVersion) componentAt(i int) uint64 {
+	if i < len(g.components) {
+		return g.components[i]
+	}
+	return 0
+}
+
+// String returns the dotted-component representation of g.
+func (g GenericVersion) String() string {
+	parts := make([]string, len(g.components))
+	for i, c := range g.components {
+		parts[i] = strconv.FormatUint(c, 10)
+	}
+	s := strings.Join(parts, ".")
+
+	if len(g.pre) > 0 {
+		preParts := make([]string, len(g.pre))
+		for i, p := range g.pre {
+			preParts[i] = p.String()
+		}
+		s += "-" + strings.Join(preParts, ".")
+	}
+	if len(g.build) > 0 {
+		s += "+" + strings.Join(g.build, ".")
+	}
+	return s
+}
+
+// Compare compares GenericVersions g to o:
+// -1 == g is less than o
+//  0 == g is equal to o
+//  1 == g is greater than o
+//
+// Missing trailing components are treated as zero, so ParseGeneric("1.2")
+// compares equal to ParseGeneric("1.2.0").
+func (g GenericVersion) Compare(o GenericVersion) int {
+	n := len(g.components)
+	if len(o.components) > n {
+		n = len(o.components)
+	}
+	for i := 0; i < n; i++ {
+		gc, oc := g.componentAt(i), o.componentAt(i)
+		if gc != oc {
+			if gc > oc {
+				return 1
+			}
+			return -1
+		}
+	}
+	return comparePreReleases(g.pre, o.pre)
+}
+
+// CompareVersion compares g against a Version, treating v's Major, Minor,
+// and Patch as the first three components. This lets a GenericVersion be
+// compared losslessly against a Version for the first three or four
+// components.
+//
+// The fourth component (Revision) only participates in the comparison when
+// both g and v have one explicitly: a Version with no revision (Revision
+// == -1) matches any revision, and a GenericVersion parsed with fewer than
+// four components likewise expresses no opinion about one. This mirrors
+// the "missing revision matches any revision" rule Version.Compare itself
+// uses, so the two compare paths never contradict each other.
+func (g GenericVersion) CompareVersion(v Version) int {
+	triplet := [3]uint64{v.Major, v.Minor, v.Patch}
+	for i := 0; i < 3; i++ {
+		gc := g.componentAt(i)
+		if gc != triplet[i] {
+			if gc > triplet[i] {
+				return 1
+			}
+			return -1
+		}
+	}
+
+	if len(g.components) >= 4 && v.Revision >= 0 {
+		gr := g.components[3]
+		vr := uint64(v.Revision)
+		if gr != vr {
+			if gr > vr {
+				return 1
+			}
+			return -1
+		}
+	}
+
+	for i := 4; i < len(g.components); i++ {
+		if g.components[i] != 0 {
+			return 1
+		}
+	}
+
+	return comparePreReleases(g.pre, v.Pre)
+}
+
+// CompareGeneric compares v against a GenericVersion. See
+// GenericVersion.CompareVersion.
+func (v Version) CompareGeneric(g GenericVersion) int {
+	return -g.CompareVersion(v)
+}
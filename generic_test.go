@@ -0,0 +1,125 @@
+package semver
+
+import "testing"
+
+func TestParseGeneric(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		components []uint64
+		str        string
+	}{
+		{name: "build and prerelease", input: "1.10.0-alpha.1+build.5", components: []uint64{1, 10, 0}, str: "1.10.0-alpha.1+build.5"},
+		{name: "single component", input: "2", components: []uint64{2}, str: "2"},
+		{name: "five components", input: "1.2.3.4.5", components: []uint64{1, 2, 3, 4, 5}, str: "1.2.3.4.5"},
+		{name: "v prefix", input: "v1.2.3", components: []uint64{1, 2, 3}, str: "1.2.3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := ParseGeneric(tt.input)
+			if err != nil {
+				t.Fatalf("ParseGeneric(%q) returned error: %v", tt.input, err)
+			}
+			if got := g.Components(); !equalUint64(got, tt.components) {
+				t.Errorf("Components() = %v, want %v", got, tt.components)
+			}
+			if got := g.String(); got != tt.str {
+				t.Errorf("String() = %q, want %q", got, tt.str)
+			}
+		})
+	}
+}
+
+func TestParseGenericStrict(t *testing.T) {
+	if _, err := ParseGenericStrict("v1.2.3"); err == nil {
+		t.Errorf("expected ParseGenericStrict to reject a 'v' prefix")
+	}
+	if _, err := ParseGenericStrict("1.02.3"); err == nil {
+		t.Errorf("expected ParseGenericStrict to reject leading zeroes")
+	}
+	if _, err := ParseGenericStrict("1.2.3"); err != nil {
+		t.Errorf("expected ParseGenericStrict to accept a plain version: %v", err)
+	}
+}
+
+func TestGenericVersionCompare(t *testing.T) {
+	short, _ := ParseGeneric("1.2")
+	long, _ := ParseGeneric("1.2.0")
+	if short.Compare(long) != 0 {
+		t.Errorf("expected 1.2 to compare equal to 1.2.0")
+	}
+
+	greater, _ := ParseGeneric("1.3")
+	if short.Compare(greater) != -1 {
+		t.Errorf("expected 1.2 to compare less than 1.3")
+	}
+	if greater.Compare(short) != 1 {
+		t.Errorf("expected 1.3 to compare greater than 1.2")
+	}
+}
+
+func TestGenericVersionCompareVersion(t *testing.T) {
+	g, err := ParseGeneric("1.2.3")
+	if err != nil {
+		t.Fatalf("ParseGeneric returned error: %v", err)
+	}
+	v, err := Parse("1.2.3")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if g.CompareVersion(v) != 0 {
+		t.Errorf("expected GenericVersion(1.2.3) to compare equal to Version(1.2.3)")
+	}
+	if v.CompareGeneric(g) != 0 {
+		t.Errorf("expected Version(1.2.3) to compare equal to GenericVersion(1.2.3)")
+	}
+
+	gRevision, err := ParseGeneric("1.2.3.4")
+	if err != nil {
+		t.Fatalf("ParseGeneric returned error: %v", err)
+	}
+	vRevision, err := Parse("1.2.3.4")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if gRevision.CompareVersion(vRevision) != 0 {
+		t.Errorf("expected GenericVersion(1.2.3.4) to compare equal to Version(1.2.3.4)")
+	}
+	if gRevision.CompareVersion(v) != 0 {
+		t.Errorf("expected GenericVersion(1.2.3.4) to compare equal to Version(1.2.3), " +
+			"since a Version with no revision matches any revision")
+	}
+	if v.CompareGeneric(gRevision) != 0 {
+		t.Errorf("expected Version(1.2.3) to compare equal to GenericVersion(1.2.3.4)")
+	}
+}
+
+func TestGenericVersionCompareVersionBothRevisionsExplicit(t *testing.T) {
+	g, err := ParseGeneric("1.2.3.4")
+	if err != nil {
+		t.Fatalf("ParseGeneric returned error: %v", err)
+	}
+	higher, err := Parse("1.2.3.5")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if g.CompareVersion(higher) != -1 {
+		t.Errorf("expected GenericVersion(1.2.3.4) to compare less than Version(1.2.3.5)")
+	}
+	if higher.CompareGeneric(g) != 1 {
+		t.Errorf("expected Version(1.2.3.5) to compare greater than GenericVersion(1.2.3.4)")
+	}
+}
+
+func equalUint64(a, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
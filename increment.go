@@ -0,0 +1,89 @@
+package semver
+
+import (
+	"errors"
+	"strings"
+)
+
+// incrementConfig holds the resolved behavior for an Increment* call.
+type incrementConfig struct {
+	clearPrereleaseBuild bool
+}
+
+// IncrementOption configures the behavior of the IncrementMajor, IncrementMinor,
+// IncrementPatch, and IncrementRevision methods.
+type IncrementOption func(*incrementConfig)
+
+// WithClearPrereleaseBuild makes an Increment* call also clear any existing
+// prerelease and build metadata on the version, in addition to its usual
+// numeric increment.
+func WithClearPrereleaseBuild() IncrementOption {
+	return func(c *incrementConfig) {
+		c.clearPrereleaseBuild = true
+	}
+}
+
+func applyIncrementOptions(v *Version, opts []IncrementOption) {
+	var c incrementConfig
+	for _, opt := range opts {
+		opt(&c)
+	}
+	if c.clearPrereleaseBuild {
+		v.Pre = nil
+		v.Build = nil
+	}
+}
+
+// SetPreRelease parses s as a dot-separated prerelease identifier list and
+// sets it as v's prerelease, replacing any existing one.
+func (v *Version) SetPreRelease(s string) error {
+	if len(s) == 0 {
+		return errors.New("Prerelease is empty")
+	}
+
+	var pre []PRVersion
+	for _, p := range strings.Split(s, ".") {
+		prv, err := NewPRVersion(p)
+		if err != nil {
+			return err
+		}
+		pre = append(pre, prv)
+	}
+	v.Pre = pre
+	return nil
+}
+
+// IncrementPreRelease bumps the last numeric identifier of v's prerelease.
+// If the last identifier is not numeric, a new numeric identifier ".1" is
+// appended instead. It returns an error if v has no prerelease.
+func (v *Version) IncrementPreRelease() error {
+	if len(v.Pre) == 0 {
+		return errors.New("Version has no prerelease to increment")
+	}
+
+	last := len(v.Pre) - 1
+	if v.Pre[last].IsNum {
+		v.Pre[last].VersionNum++
+		return nil
+	}
+
+	v.Pre = append(v.Pre, PRVersion{IsNum: true, VersionNum: 1})
+	return nil
+}
+
+// Finalize discards v's prerelease and build metadata in place, leaving
+// only the major, minor, patch, and (if present) revision numbers. See
+// FinalizeVersion for the string-returning equivalent.
+func (v *Version) Finalize() {
+	v.Pre = nil
+	v.Build = nil
+}
+
+// NextStable returns the release version implied by v's current
+// prerelease, e.g. "1.2.3-rc.2" becomes "1.2.3". If v is already a release
+// version, NextStable returns it unchanged.
+func (v Version) NextStable() Version {
+	v.Pre = nil
+	v.Build = nil
+	return v
+}
@@ -0,0 +1,123 @@
+package semver
+
+import "testing"
+
+func TestIncrementMinorWithRevision(t *testing.T) {
+	v, err := Parse("1.2.3.4")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if err := v.IncrementMinor(); err != nil {
+		t.Fatalf("IncrementMinor returned error: %v", err)
+	}
+	if v.String() != "1.3.0.0" {
+		t.Fatalf("IncrementMinor = %s, want 1.3.0.0", v)
+	}
+}
+
+func TestIncrementClearsPrereleaseBuildWhenRequested(t *testing.T) {
+	v, err := Parse("1.2.3-rc.1+build.5")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if err := v.IncrementPatch(WithClearPrereleaseBuild()); err != nil {
+		t.Fatalf("IncrementPatch returned error: %v", err)
+	}
+	if v.String() != "1.2.4" {
+		t.Fatalf("IncrementPatch = %s, want 1.2.4", v)
+	}
+}
+
+func TestIncrementPreservesPrereleaseBuildByDefault(t *testing.T) {
+	v, err := Parse("1.2.3-rc.1+build.5")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if err := v.IncrementPatch(); err != nil {
+		t.Fatalf("IncrementPatch returned error: %v", err)
+	}
+	if v.String() != "1.2.4-rc.1+build.5" {
+		t.Fatalf("IncrementPatch = %s, want 1.2.4-rc.1+build.5", v)
+	}
+}
+
+func TestSetPreRelease(t *testing.T) {
+	v, err := Parse("1.2.3")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if err := v.SetPreRelease("rc.1"); err != nil {
+		t.Fatalf("SetPreRelease returned error: %v", err)
+	}
+	if v.String() != "1.2.3-rc.1" {
+		t.Fatalf("SetPreRelease = %s, want 1.2.3-rc.1", v)
+	}
+	if err := v.SetPreRelease(""); err == nil {
+		t.Fatalf("expected SetPreRelease(\"\") to return an error")
+	}
+}
+
+func TestIncrementPreRelease(t *testing.T) {
+	v, err := Parse("1.2.3-rc.1")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if err := v.IncrementPreRelease(); err != nil {
+		t.Fatalf("IncrementPreRelease returned error: %v", err)
+	}
+	if v.String() != "1.2.3-rc.2" {
+		t.Fatalf("IncrementPreRelease = %s, want 1.2.3-rc.2", v)
+	}
+
+	alpha, err := Parse("1.2.3-alpha")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if err := alpha.IncrementPreRelease(); err != nil {
+		t.Fatalf("IncrementPreRelease returned error: %v", err)
+	}
+	if alpha.String() != "1.2.3-alpha.1" {
+		t.Fatalf("IncrementPreRelease = %s, want 1.2.3-alpha.1", alpha)
+	}
+
+	release, err := Parse("1.2.3")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if err := release.IncrementPreRelease(); err == nil {
+		t.Fatalf("expected IncrementPreRelease on a release version to return an error")
+	}
+}
+
+func TestFinalize(t *testing.T) {
+	v, err := Parse("1.2.3-rc.1+build.5")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	v.Finalize()
+	if v.String() != "1.2.3" {
+		t.Fatalf("Finalize = %s, want 1.2.3", v)
+	}
+}
+
+func TestNextStable(t *testing.T) {
+	v, err := Parse("1.2.3-rc.2")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	next := v.NextStable()
+	if next.String() != "1.2.3" {
+		t.Fatalf("NextStable = %s, want 1.2.3", next)
+	}
+	if v.String() != "1.2.3-rc.2" {
+		t.Fatalf("NextStable mutated the receiver: %s", v)
+	}
+
+	release, err := Parse("1.2.3")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if release.NextStable().String() != "1.2.3" {
+		t.Fatalf("NextStable on a release version should be a no-op")
+	}
+}
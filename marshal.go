@@ -0,0 +1,104 @@
+package semver
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// UnmarshalTolerant controls whether UnmarshalJSON, UnmarshalYAML, Scan, and
+// UnmarshalText parse using ParseTolerant instead of Parse, allowing version
+// strings that do not strictly conform to semver. It defaults to false.
+var UnmarshalTolerant = false
+
+func unmarshalString(s string) (Version, error) {
+	if UnmarshalTolerant {
+		return ParseTolerant(s)
+	}
+	return Parse(s)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (v Version) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (v *Version) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	parsed, err := unmarshalString(s)
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v Version) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (v *Version) UnmarshalText(text []byte) error {
+	parsed, err := unmarshalString(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// MarshalYAML implements the yaml.Marshaler interface.
+func (v Version) MarshalYAML() (interface{}, error) {
+	return v.String(), nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (v *Version) UnmarshalYAML(value *yaml.Node) error {
+	var s string
+	if err := value.Decode(&s); err != nil {
+		return err
+	}
+	parsed, err := unmarshalString(s)
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// Value implements the database/sql/driver.Valuer interface, storing a
+// Version as its string representation.
+func (v Version) Value() (driver.Value, error) {
+	return v.String(), nil
+}
+
+// Scan implements the database/sql.Scanner interface, reading a Version
+// back from the string or byte slice stored by Value.
+func (v *Version) Scan(src interface{}) error {
+	var s string
+	switch t := src.(type) {
+	case string:
+		s = t
+	case []byte:
+		s = string(t)
+	case nil:
+		return errors.New("Version: cannot scan nil into Version")
+	default:
+		return fmt.Errorf("Version: cannot scan type %T into Version", src)
+	}
+
+	parsed, err := unmarshalString(s)
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
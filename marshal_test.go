@@ -0,0 +1,124 @@
+package semver
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestVersionJSONRoundTrip(t *testing.T) {
+	for _, s := range []string{"1.2.3", "1.2.3.4", "1.2.3-rc.1+build.5"} {
+		v, err := Parse(s)
+		if err != nil {
+			t.Fatalf("Parse(%q) returned error: %v", s, err)
+		}
+
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("MarshalJSON(%q) returned error: %v", s, err)
+		}
+
+		var got Version
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("UnmarshalJSON(%q) returned error: %v", s, err)
+		}
+		if !got.Equals(v) {
+			t.Errorf("round trip mismatch: got %s, want %s", got, v)
+		}
+	}
+}
+
+func TestVersionUnmarshalJSONTolerant(t *testing.T) {
+	UnmarshalTolerant = true
+	defer func() { UnmarshalTolerant = false }()
+
+	var got Version
+	if err := json.Unmarshal([]byte(`"v1.2"`), &got); err != nil {
+		t.Fatalf("UnmarshalJSON returned error: %v", err)
+	}
+	want, _ := Parse("1.2.0")
+	if !got.Equals(want) {
+		t.Errorf("expected tolerant parse of \"v1.2\" to equal %s, got %s", want, got)
+	}
+}
+
+func TestVersionSQLRoundTrip(t *testing.T) {
+	v, err := Parse("1.2.3.4")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+
+	value, err := v.Value()
+	if err != nil {
+		t.Fatalf("Value returned error: %v", err)
+	}
+	s, ok := value.(string)
+	if !ok {
+		t.Fatalf("expected Value to return a string, got %T", value)
+	}
+
+	var got Version
+	if err := got.Scan(s); err != nil {
+		t.Fatalf("Scan(string) returned error: %v", err)
+	}
+	if !got.Equals(v) {
+		t.Errorf("round trip mismatch: got %s, want %s", got, v)
+	}
+
+	var fromBytes Version
+	if err := fromBytes.Scan([]byte(s)); err != nil {
+		t.Fatalf("Scan([]byte) returned error: %v", err)
+	}
+	if !fromBytes.Equals(v) {
+		t.Errorf("round trip mismatch: got %s, want %s", fromBytes, v)
+	}
+
+	var fromNil Version
+	if err := fromNil.Scan(nil); err == nil {
+		t.Errorf("expected Scan(nil) to return an error")
+	}
+
+	var _ driver.Valuer = v
+}
+
+func TestVersionYAMLRoundTrip(t *testing.T) {
+	v, err := Parse("1.2.3.4")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+
+	data, err := yaml.Marshal(v)
+	if err != nil {
+		t.Fatalf("yaml.Marshal returned error: %v", err)
+	}
+
+	var got Version
+	if err := yaml.Unmarshal(data, &got); err != nil {
+		t.Fatalf("yaml.Unmarshal returned error: %v", err)
+	}
+	if !got.Equals(v) {
+		t.Errorf("round trip mismatch: got %s, want %s", got, v)
+	}
+}
+
+func TestVersionTextRoundTrip(t *testing.T) {
+	v, err := Parse("1.2.3")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+
+	text, err := v.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText returned error: %v", err)
+	}
+
+	var got Version
+	if err := got.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText returned error: %v", err)
+	}
+	if !got.Equals(v) {
+		t.Errorf("round trip mismatch: got %s, want %s", got, v)
+	}
+}
@@ -0,0 +1,322 @@
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	rangeComparatorRegex = regexp.MustCompile(
+		`^(?P<op>=|!=|<=|<|>=|>|~|\^)?` +
+			`(?P<major>[0-9]+|[xX*])` +
+			`(?:\.(?P<minor>[0-9]+|[xX*]))?` +
+			`(?:\.(?P<patch>[0-9]+|[xX*]))?` +
+			`(?:\.(?P<revision>[0-9]+|[xX*]))?` +
+			`(?:-(?P<prerelease>[0-9A-Za-z.-]+))?` +
+			`(?:\+(?P<build>[0-9A-Za-z.-]+))?$`)
+
+	// rangeOperatorSpace lets callers put whitespace between an operator and
+	// its version (e.g. "> 1.2.3") before the expression is split into
+	// whitespace-separated AND terms. An operator followed by whitespace is
+	// only ever followed by a version token (or a parse error), so the
+	// whitespace can always be collapsed.
+	rangeOperatorSpace = regexp.MustCompile(`(=|!=|<=|<|>=|>|~|\^)\s+`)
+)
+
+// Range is a predicate that reports whether a Version satisfies a set of
+// version constraints.
+type Range func(Version) bool
+
+// prereleaseAnchor records the [major, minor, patch, revision] tuple of a
+// comparator that explicitly mentioned a prerelease. A prerelease Version
+// only ever satisfies a Range if it matches one of these tuples, mirroring
+// the npm/cargo rule that prereleases are excluded from ranges unless the
+// range itself targets that exact prerelease line. Revision of -1 means the
+// comparator did not mention a revision and so matches any revision.
+type prereleaseAnchor struct {
+	major, minor, patch uint64
+	revision            int64
+}
+
+// AND returns a Range satisfied only when both r and o are satisfied.
+func (r Range) AND(o Range) Range {
+	return func(v Version) bool { return r(v) && o(v) }
+}
+
+// OR returns a Range satisfied when either r or o is satisfied.
+func (r Range) OR(o Range) Range {
+	return func(v Version) bool { return r(v) || o(v) }
+}
+
+// Satisfies reports whether v satisfies the Range r.
+func (v Version) Satisfies(r Range) bool {
+	return r(v)
+}
+
+// ParseRange parses a npm/blang-style range expression and returns a Range
+// that evaluates it. The grammar supports comparators (=, !=, <, <=, >, >=),
+// whitespace-joined AND groups, "||"-joined OR groups, the shorthand
+// operators ~ and ^, and wildcards (x, X, *) in any version component.
+//
+// A Version with a prerelease only satisfies the Range if the matching
+// "||" branch contains a comparator that explicitly mentions a prerelease
+// on the same [major, minor, patch, revision] tuple.
+func ParseRange(s string) (Range, error) {
+	s = strings.TrimSpace(s)
+	if len(s) == 0 {
+		return nil, errors.New("Range string empty")
+	}
+
+	orGroups := strings.Split(s, "||")
+	ranges := make([]Range, 0, len(orGroups))
+
+	for _, group := range orGroups {
+		group = strings.TrimSpace(group)
+		if len(group) == 0 {
+			return nil, fmt.Errorf("Invalid range: '%s'", s)
+		}
+
+		normalized := rangeOperatorSpace.ReplaceAllString(group, "$1")
+		tokens := strings.Fields(normalized)
+		preds := make([]func(Version) bool, 0, len(tokens))
+		var anchors []prereleaseAnchor
+		for _, tok := range tokens {
+			pred, anchor, err := parseComparator(tok)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid range '%s': %w", s, err)
+			}
+			preds = append(preds, pred)
+			if anchor != nil {
+				anchors = append(anchors, *anchor)
+			}
+		}
+
+		// The prerelease-inclusion rule is scoped to this OR-branch only:
+		// a prerelease must not be let in merely because some other,
+		// unrelated branch happens to target the same tuple.
+		groupRange := andPredicates(preds)
+		groupAnchors := anchors
+		ranges = append(ranges, func(v Version) bool {
+			if len(v.Pre) > 0 && !anyAnchorMatches(groupAnchors, v) {
+				return false
+			}
+			return groupRange(v)
+		})
+	}
+
+	return orRanges(ranges), nil
+}
+
+func andPredicates(preds []func(Version) bool) Range {
+	return func(v Version) bool {
+		for _, p := range preds {
+			if !p(v) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+func orRanges(ranges []Range) Range {
+	return func(v Version) bool {
+		for _, r := range ranges {
+			if r(v) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func anyAnchorMatches(anchors []prereleaseAnchor, v Version) bool {
+	for _, a := range anchors {
+		if a.major == v.Major && a.minor == v.Minor && a.patch == v.Patch &&
+			(a.revision == -1 || a.revision == v.Revision) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseComparator parses a single comparator token (e.g. "~1.2.3",
+// ">=1.2.x") into a predicate and, if the token mentions a prerelease, the
+// anchor tuple that prerelease should be matched against.
+func parseComparator(tok string) (func(Version) bool, *prereleaseAnchor, error) {
+	m := rangeComparatorRegex.FindStringSubmatch(tok)
+	if m == nil {
+		return nil, nil, fmt.Errorf("invalid comparator %q", tok)
+	}
+
+	op := m[rangeComparatorRegex.SubexpIndex("op")]
+	majorStr := m[rangeComparatorRegex.SubexpIndex("major")]
+	minorStr := m[rangeComparatorRegex.SubexpIndex("minor")]
+	patchStr := m[rangeComparatorRegex.SubexpIndex("patch")]
+	revisionStr := m[rangeComparatorRegex.SubexpIndex("revision")]
+	preStr := m[rangeComparatorRegex.SubexpIndex("prerelease")]
+	buildStr := m[rangeComparatorRegex.SubexpIndex("build")]
+
+	major, minor, patch, revision, revisionGiven, err := parseRangeComponents(majorStr, minorStr, patchStr, revisionStr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var pre []PRVersion
+	if len(preStr) > 0 {
+		for _, p := range strings.Split(preStr, ".") {
+			prv, err := NewPRVersion(p)
+			if err != nil {
+				return nil, nil, err
+			}
+			pre = append(pre, prv)
+		}
+	}
+	var build []string
+	if len(buildStr) > 0 {
+		build = strings.Split(buildStr, ".")
+	}
+
+	var anchor *prereleaseAnchor
+	if len(preStr) > 0 {
+		rev := int64(-1)
+		if revisionGiven {
+			rev = revision
+		}
+		anchor = &prereleaseAnchor{major: major, minor: minor, patch: patch, revision: rev}
+	}
+
+	majorWild := isWildcardToken(majorStr)
+	minorWild := len(minorStr) == 0 || isWildcardToken(minorStr)
+	patchWild := len(patchStr) == 0 || isWildcardToken(patchStr)
+
+	switch {
+	case majorWild:
+		return func(Version) bool { return true }, anchor, nil
+	case minorWild:
+		if op == "" || op == "~" || op == "^" {
+			lower := Version{Major: major, Revision: -1}
+			upper := Version{Major: major + 1, Revision: -1}
+			return rangePredicate(lower, upper), anchor, nil
+		}
+		v := Version{Major: major, Revision: -1, Pre: pre, Build: build}
+		return comparatorPredicate(op, v), anchor, nil
+	case patchWild:
+		if op == "" || op == "~" || op == "^" {
+			lower := Version{Major: major, Minor: minor, Revision: -1}
+			upper := Version{Major: major, Minor: minor + 1, Revision: -1}
+			if op == "^" && major > 0 {
+				upper = Version{Major: major + 1, Revision: -1}
+			}
+			return rangePredicate(lower, upper), anchor, nil
+		}
+		v := Version{Major: major, Minor: minor, Revision: -1, Pre: pre, Build: build}
+		return comparatorPredicate(op, v), anchor, nil
+	}
+
+	rev := int64(-1)
+	if revisionGiven {
+		rev = revision
+	}
+	v := Version{Major: major, Minor: minor, Patch: patch, Revision: rev, Pre: pre, Build: build}
+
+	switch op {
+	case "", "=":
+		return comparatorPredicate("=", v), anchor, nil
+	case "!=", "<", "<=", ">", ">=":
+		return comparatorPredicate(op, v), anchor, nil
+	case "~":
+		var upper Version
+		if revisionGiven {
+			upper = Version{Major: major, Minor: minor, Patch: patch + 1, Revision: -1}
+		} else {
+			upper = Version{Major: major, Minor: minor + 1, Revision: -1}
+		}
+		return rangePredicate(v, upper), anchor, nil
+	case "^":
+		upper := caretUpper(major, minor, patch, revision, revisionGiven)
+		return rangePredicate(v, upper), anchor, nil
+	}
+
+	return nil, nil, fmt.Errorf("invalid comparator %q", tok)
+}
+
+// rangePredicate returns a predicate matching versions in [lower, upper).
+func rangePredicate(lower, upper Version) func(Version) bool {
+	return func(v Version) bool {
+		return v.Compare(lower) >= 0 && v.Compare(upper) < 0
+	}
+}
+
+func comparatorPredicate(op string, v Version) func(Version) bool {
+	switch op {
+	case "=":
+		return func(c Version) bool { return c.Compare(v) == 0 }
+	case "!=":
+		return func(c Version) bool { return c.Compare(v) != 0 }
+	case "<":
+		return func(c Version) bool { return c.Compare(v) < 0 }
+	case "<=":
+		return func(c Version) bool { return c.Compare(v) <= 0 }
+	case ">":
+		return func(c Version) bool { return c.Compare(v) > 0 }
+	case ">=":
+		return func(c Version) bool { return c.Compare(v) >= 0 }
+	}
+	return func(Version) bool { return false }
+}
+
+// caretUpper returns the exclusive upper bound for a "^" comparator: the
+// version is allowed to vary at and below the leftmost non-zero component.
+func caretUpper(major, minor, patch uint64, revision int64, revisionGiven bool) Version {
+	switch {
+	case major > 0:
+		return Version{Major: major + 1, Revision: -1}
+	case minor > 0:
+		return Version{Minor: minor + 1, Revision: -1}
+	case patch > 0:
+		return Version{Patch: patch + 1, Revision: -1}
+	case revisionGiven:
+		return Version{Revision: revision + 1}
+	default:
+		return Version{Patch: 1, Revision: -1}
+	}
+}
+
+func parseRangeComponents(majorStr, minorStr, patchStr, revisionStr string) (major, minor, patch uint64, revision int64, revisionGiven bool, err error) {
+	major, err = parseUintOrZero(majorStr)
+	if err != nil {
+		return
+	}
+	minor, err = parseUintOrZero(minorStr)
+	if err != nil {
+		return
+	}
+	patch, err = parseUintOrZero(patchStr)
+	if err != nil {
+		return
+	}
+	if len(revisionStr) > 0 && !isWildcardToken(revisionStr) {
+		var r uint64
+		r, err = parseUintOrZero(revisionStr)
+		if err != nil {
+			return
+		}
+		revision = int64(r)
+		revisionGiven = true
+	}
+	return
+}
+
+func parseUintOrZero(s string) (uint64, error) {
+	if len(s) == 0 || isWildcardToken(s) {
+		return 0, nil
+	}
+	return strconv.ParseUint(s, 10, 64)
+}
+
+func isWildcardToken(s string) bool {
+	return s == "x" || s == "X" || s == "*"
+}
@@ -0,0 +1,208 @@
+package semver
+
+import "testing"
+
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		name  string
+		rng   string
+		match []string
+		skip  []string
+	}{
+		{
+			name:  "exact match",
+			rng:   "1.2.3",
+			match: []string{"1.2.3"},
+			skip:  []string{"1.2.4", "1.2.2"},
+		},
+		{
+			name:  "comparison operators",
+			rng:   ">=1.2.3 <2.0.0",
+			match: []string{"1.2.3", "1.9.9"},
+			skip:  []string{"1.2.2", "2.0.0"},
+		},
+		{
+			name:  "operator with whitespace",
+			rng:   "> 1.2.3",
+			match: []string{"1.2.4"},
+			skip:  []string{"1.2.3"},
+		},
+		{
+			name:  "or groups",
+			rng:   "1.2.3 || 2.0.0",
+			match: []string{"1.2.3", "2.0.0"},
+			skip:  []string{"1.2.4"},
+		},
+		{
+			name:  "tilde patch range",
+			rng:   "~1.2.3",
+			match: []string{"1.2.3", "1.2.9"},
+			skip:  []string{"1.3.0", "1.2.2"},
+		},
+		{
+			name:  "tilde with revision bounds on patch",
+			rng:   "~1.2.3.4",
+			match: []string{"1.2.3.4", "1.2.3.99"},
+			skip:  []string{"1.2.4.0", "1.2.3.3"},
+		},
+		{
+			name:  "tilde major.minor only",
+			rng:   "~1.2",
+			match: []string{"1.2.0", "1.2.9"},
+			skip:  []string{"1.3.0"},
+		},
+		{
+			name:  "caret locks major",
+			rng:   "^1.2.3",
+			match: []string{"1.2.3", "1.9.9"},
+			skip:  []string{"2.0.0", "1.2.2"},
+		},
+		{
+			name:  "caret with zero major locks minor",
+			rng:   "^0.2.3",
+			match: []string{"0.2.3", "0.2.9"},
+			skip:  []string{"0.3.0", "0.2.2"},
+		},
+		{
+			name:  "caret with zero major and minor locks patch",
+			rng:   "^0.0.3",
+			match: []string{"0.0.3"},
+			skip:  []string{"0.0.4", "0.0.2"},
+		},
+		{
+			name:  "wildcard in patch position",
+			rng:   "1.2.x",
+			match: []string{"1.2.0", "1.2.9"},
+			skip:  []string{"1.3.0", "1.1.9"},
+		},
+		{
+			name:  "wildcard in minor position",
+			rng:   "1.X",
+			match: []string{"1.0.0", "1.9.9"},
+			skip:  []string{"2.0.0"},
+		},
+		{
+			name:  "bare star matches everything",
+			rng:   "*",
+			match: []string{"0.0.1", "9.9.9"},
+		},
+		{
+			name:  "not equal excludes a version",
+			rng:   "!=1.2.3",
+			match: []string{"1.2.4"},
+			skip:  []string{"1.2.3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := ParseRange(tt.rng)
+			if err != nil {
+				t.Fatalf("ParseRange(%q) returned error: %v", tt.rng, err)
+			}
+			for _, s := range tt.match {
+				v, err := Parse(s)
+				if err != nil {
+					t.Fatalf("Parse(%q) returned error: %v", s, err)
+				}
+				if !v.Satisfies(r) {
+					t.Errorf("expected %q to satisfy range %q", s, tt.rng)
+				}
+			}
+			for _, s := range tt.skip {
+				v, err := Parse(s)
+				if err != nil {
+					t.Fatalf("Parse(%q) returned error: %v", s, err)
+				}
+				if v.Satisfies(r) {
+					t.Errorf("expected %q to not satisfy range %q", s, tt.rng)
+				}
+			}
+		})
+	}
+}
+
+func TestParseRangePrereleaseInclusion(t *testing.T) {
+	r, err := ParseRange(">=1.2.3-alpha <2.0.0")
+	if err != nil {
+		t.Fatalf("ParseRange returned error: %v", err)
+	}
+
+	matching, err := Parse("1.2.3-alpha.1")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if !matching.Satisfies(r) {
+		t.Errorf("expected prerelease on the same tuple as the comparator to satisfy the range")
+	}
+
+	other, err := Parse("1.5.0-beta")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if other.Satisfies(r) {
+		t.Errorf("expected prerelease on a different tuple to not satisfy the range")
+	}
+}
+
+func TestParseRangePrereleaseAnchorIsScopedPerBranch(t *testing.T) {
+	r, err := ParseRange("1.2.3-alpha || >=1.0.0 <2.0.0")
+	if err != nil {
+		t.Fatalf("ParseRange returned error: %v", err)
+	}
+
+	v, err := Parse("1.2.3-zzz")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if v.Satisfies(r) {
+		t.Errorf("expected a prerelease not anchored in its own matching branch to not satisfy the range, " +
+			"even though an unrelated OR-branch mentions the same tuple")
+	}
+}
+
+func TestParseRangeOperatorSpaceDoesNotPanicOnLoad(t *testing.T) {
+	if _, err := ParseRange("> 1.2.3 <= 2.0.0"); err != nil {
+		t.Fatalf("ParseRange returned error: %v", err)
+	}
+}
+
+func TestRangeCombinators(t *testing.T) {
+	a, err := ParseRange(">=1.0.0")
+	if err != nil {
+		t.Fatalf("ParseRange returned error: %v", err)
+	}
+	b, err := ParseRange("<2.0.0")
+	if err != nil {
+		t.Fatalf("ParseRange returned error: %v", err)
+	}
+
+	and := a.AND(b)
+	or := a.OR(b)
+
+	v1, _ := Parse("1.5.0")
+	v2, _ := Parse("2.5.0")
+	v3, _ := Parse("0.5.0")
+
+	if !v1.Satisfies(and) {
+		t.Errorf("expected 1.5.0 to satisfy the AND range")
+	}
+	if v2.Satisfies(and) {
+		t.Errorf("expected 2.5.0 to not satisfy the AND range")
+	}
+	if !v2.Satisfies(or) {
+		t.Errorf("expected 2.5.0 to satisfy the OR range")
+	}
+	if !v3.Satisfies(or) {
+		t.Errorf("expected 0.5.0 to satisfy the OR range")
+	}
+}
+
+func TestParseRangeInvalid(t *testing.T) {
+	invalid := []string{"", "   ", "1.2.3 ||", ">= abc"}
+	for _, s := range invalid {
+		if _, err := ParseRange(s); err == nil {
+			t.Errorf("expected ParseRange(%q) to return an error", s)
+		}
+	}
+}
@@ -179,18 +179,25 @@ func (v Version) Compare(o Version) int {
 		return -1
 	}
 
+	return comparePreReleases(v.Pre, o.Pre)
+}
+
+// comparePreReleases compares two dot-separated prerelease identifier lists
+// per semver precedence rules. It backs both Version.Compare and
+// GenericVersion.Compare so the two types stay consistent with each other.
+func comparePreReleases(a, b []PRVersion) int {
 	// Quick comparison if a version has no prerelease versions
-	if len(v.Pre) == 0 && len(o.Pre) == 0 {
+	if len(a) == 0 && len(b) == 0 {
 		return 0
-	} else if len(v.Pre) == 0 && len(o.Pre) > 0 {
+	} else if len(a) == 0 && len(b) > 0 {
 		return 1
-	} else if len(v.Pre) > 0 && len(o.Pre) == 0 {
+	} else if len(a) > 0 && len(b) == 0 {
 		return -1
 	}
 
 	i := 0
-	for ; i < len(v.Pre) && i < len(o.Pre); i++ {
-		if comp := v.Pre[i].Compare(o.Pre[i]); comp == 0 {
+	for ; i < len(a) && i < len(b); i++ {
+		if comp := a[i].Compare(b[i]); comp == 0 {
 			continue
 		} else if comp == 1 {
 			return 1
@@ -200,51 +207,54 @@ func (v Version) Compare(o Version) int {
 	}
 
 	// If all pr versions are the equal but one has further prversion, this one greater
-	if i == len(v.Pre) && i == len(o.Pre) {
+	if i == len(a) && i == len(b) {
 		return 0
-	} else if i == len(v.Pre) && i < len(o.Pre) {
+	} else if i == len(a) && i < len(b) {
 		return -1
 	} else {
 		return 1
 	}
-
 }
 
-// IncrementPatch increments the patch version
-func (v *Version) IncrementRevision() error {
+// IncrementRevision increments the revision version
+func (v *Version) IncrementRevision(opts ...IncrementOption) error {
 	if v.Revision >= 0 {
 		v.Revision++
 	}
+	applyIncrementOptions(v, opts)
 	return nil
 }
 
 // IncrementPatch increments the patch version
-func (v *Version) IncrementPatch() error {
+func (v *Version) IncrementPatch(opts ...IncrementOption) error {
 	v.Patch++
 	if v.Revision >= 0 {
 		v.Revision = 0
 	}
+	applyIncrementOptions(v, opts)
 	return nil
 }
 
 // IncrementMinor increments the minor version
-func (v *Version) IncrementMinor() error {
+func (v *Version) IncrementMinor(opts ...IncrementOption) error {
 	v.Minor++
 	v.Patch = 0
 	if v.Revision >= 0 {
 		v.Revision = 0
 	}
+	applyIncrementOptions(v, opts)
 	return nil
 }
 
 // IncrementMajor increments the major version
-func (v *Version) IncrementMajor() error {
+func (v *Version) IncrementMajor(opts ...IncrementOption) error {
 	v.Major++
 	v.Minor = 0
 	v.Patch = 0
 	if v.Revision >= 0 {
 		v.Revision = 0
 	}
+	applyIncrementOptions(v, opts)
 	return nil
 }
 
@@ -293,7 +303,7 @@ func Make(s string) (Version, error) {
 // with only major and minor components specified, and removes leading 0s.
 func ParseTolerant(s string) (Version, error) {
 	if !KongTolerantVersionRegex.MatchString(s) {
-		return Version{}, fmt.Errorf("Invalid tolerant version: '%s'", s)
+		return Version{}, diagnoseTolerantMismatch(s)
 	}
 
 	// Split into major.minor.patch.revision-pr+build and remove leading zeros from
@@ -336,7 +346,18 @@ func ParseTolerant(s string) (Version, error) {
 	majorLen := len(majorStr)
 	if patchLen == 0 || minorLen == 0 || majorLen == 0 {
 		if len(prereleaseStr) > 0 || len(buildStr) > 0 {
-			return Version{}, errors.New("Short version cannot contain PreRelease/Build meta data")
+			missing := "patch"
+			switch {
+			case majorLen == 0:
+				missing = "major"
+			case minorLen == 0:
+				missing = "minor"
+			}
+			pos := strings.IndexAny(s, "-+")
+			if pos < 0 {
+				pos = 0
+			}
+			return Version{}, &ParseError{Input: s, Position: pos, Component: missing, Reason: ErrInvalidChar}
 		}
 		if len(patchStr) == 0 {
 			patchStr = "0"
@@ -367,25 +388,29 @@ func ParseTolerant(s string) (Version, error) {
 // Parse parses version string and returns a validated Version or error
 func Parse(s string) (Version, error) {
 	if len(s) == 0 {
-		return Version{}, errors.New("Version string empty")
+		return Version{}, &ParseError{Input: s, Position: 0, Component: "major", Reason: ErrEmptyIdentifier}
 	}
 	if !KongVersionRegex.MatchString(s) {
-		return Version{}, fmt.Errorf("Invalid version: '%s'", s)
+		return Version{}, diagnoseParseError(s)
 	}
 
 	// Split into major.minor.patch.revision-pr+build
+	idx := KongVersionRegex.FindStringSubmatchIndex(s)
 	parts := KongVersionRegex.FindStringSubmatch(s)
+	majorStart := idx[2*KongVersionRegex.SubexpIndex("major")]
 	major, err := strconv.ParseUint(parts[KongVersionRegex.SubexpIndex("major")], 10, 64)
 	if err != nil {
-		return Version{}, err
+		return Version{}, &ParseError{Input: s, Position: majorStart, Component: "major", Reason: ErrOverflow}
 	}
+	minorStart := idx[2*KongVersionRegex.SubexpIndex("minor")]
 	minor, err := strconv.ParseUint(parts[KongVersionRegex.SubexpIndex("minor")], 10, 64)
 	if err != nil {
-		return Version{}, err
+		return Version{}, &ParseError{Input: s, Position: minorStart, Component: "minor", Reason: ErrOverflow}
 	}
+	patchStart := idx[2*KongVersionRegex.SubexpIndex("patch")]
 	patch, err := strconv.ParseUint(parts[KongVersionRegex.SubexpIndex("patch")], 10, 64)
 	if err != nil {
-		return Version{}, err
+		return Version{}, &ParseError{Input: s, Position: patchStart, Component: "patch", Reason: ErrOverflow}
 	}
 	revisionStr := parts[KongVersionRegex.SubexpIndex("revision")]
 	prereleaseStr := parts[KongVersionRegex.SubexpIndex("prerelease")]
@@ -398,9 +423,10 @@ func Parse(s string) (Version, error) {
 	v.Patch = patch
 	v.Revision = -1
 	if len(revisionStr) > 0 {
+		revisionStart := idx[2*KongVersionRegex.SubexpIndex("revision")]
 		revision, err := strconv.ParseInt(revisionStr, 10, 64)
 		if err != nil {
-			return Version{}, err
+			return Version{}, &ParseError{Input: s, Position: revisionStart, Component: "revision", Reason: ErrOverflow}
 		}
 		v.Revision = revision
 	}
@@ -425,10 +451,10 @@ func Parse(s string) (Version, error) {
 	// Build meta data
 	for _, str := range build {
 		if len(str) == 0 {
-			return Version{}, errors.New("Build meta data is empty")
+			return Version{}, &ParseError{Input: s, Component: "build", Reason: ErrEmptyIdentifier}
 		}
 		if !containsOnly(str, alphanum) {
-			return Version{}, fmt.Errorf("Invalid character(s) found in build meta data %q", str)
+			return Version{}, &ParseError{Input: s, Component: "build", Reason: ErrInvalidChar}
 		}
 		v.Build = append(v.Build, str)
 	}
@@ -455,18 +481,16 @@ type PRVersion struct {
 // NewPRVersion creates a new valid prerelease version
 func NewPRVersion(s string) (PRVersion, error) {
 	if len(s) == 0 {
-		return PRVersion{}, errors.New("Prerelease is empty")
+		return PRVersion{}, &ParseError{Input: s, Component: "prerelease", Reason: ErrEmptyIdentifier}
 	}
 	v := PRVersion{}
 	if containsOnly(s, numbers) {
 		if hasLeadingZeroes(s) {
-			return PRVersion{}, fmt.Errorf("Numeric PreRelease version must not contain leading zeroes %q", s)
+			return PRVersion{}, &ParseError{Input: s, Component: "prerelease", Reason: ErrLeadingZero}
 		}
 		num, err := strconv.ParseUint(s, 10, 64)
-
-		// Might never be hit, but just in case
 		if err != nil {
-			return PRVersion{}, err
+			return PRVersion{}, &ParseError{Input: s, Component: "prerelease", Reason: ErrOverflow}
 		}
 		v.VersionNum = num
 		v.IsNum = true
@@ -474,7 +498,7 @@ func NewPRVersion(s string) (PRVersion, error) {
 		v.VersionStr = s
 		v.IsNum = false
 	} else {
-		return PRVersion{}, fmt.Errorf("Invalid character(s) found in prerelease %q", s)
+		return PRVersion{}, &ParseError{Input: s, Component: "prerelease", Reason: ErrInvalidChar}
 	}
 	return v, nil
 }
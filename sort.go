@@ -0,0 +1,78 @@
+package semver
+
+import "sort"
+
+// Versions implements sort.Interface over a slice of Version, letting
+// callers compose it directly with sort.Reverse, sort.Stable, and other
+// helpers from the sort package.
+type Versions []Version
+
+// Len implements sort.Interface.
+func (s Versions) Len() int {
+	return len(s)
+}
+
+// Less implements sort.Interface.
+func (s Versions) Less(i, j int) bool {
+	return s[i].Compare(s[j]) < 0
+}
+
+// Swap implements sort.Interface.
+func (s Versions) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+// Sort sorts a slice of versions in place, ascending.
+func Sort(versions []Version) {
+	sort.Sort(Versions(versions))
+}
+
+// SortStable sorts a slice of versions in place, ascending, using a stable
+// sort so that equal versions keep their relative order.
+func SortStable(versions []Version) {
+	sort.Stable(Versions(versions))
+}
+
+// SortDesc sorts a slice of versions in place, descending.
+func SortDesc(versions []Version) {
+	sort.Sort(sort.Reverse(Versions(versions)))
+}
+
+// SortFunc sorts items in place, ascending, by the Version that key
+// extracts from each item. This is useful for callers that hold structs
+// with a version field rather than a bare []Version.
+func SortFunc[T any](items []T, key func(T) Version) {
+	sort.Slice(items, func(i, j int) bool {
+		return key(items[i]).Compare(key(items[j])) < 0
+	})
+}
+
+// SortSliceByVersionString sorts items in place, ascending, by parsing the
+// version string that key extracts from each item. It returns the first
+// parse error encountered, if any, leaving items unsorted.
+func SortSliceByVersionString[T any](items []T, key func(T) string) error {
+	type indexedVersion struct {
+		version Version
+		index   int
+	}
+
+	indexed := make([]indexedVersion, len(items))
+	for i, item := range items {
+		v, err := Parse(key(item))
+		if err != nil {
+			return err
+		}
+		indexed[i] = indexedVersion{version: v, index: i}
+	}
+
+	sort.Slice(indexed, func(i, j int) bool {
+		return indexed[i].version.Compare(indexed[j].version) < 0
+	})
+
+	sorted := make([]T, len(items))
+	for i, iv := range indexed {
+		sorted[i] = items[iv.index]
+	}
+	copy(items, sorted)
+	return nil
+}
@@ -1,6 +1,8 @@
 package semver
 
 import (
+	"fmt"
+	"math/rand"
 	"reflect"
 	"testing"
 )
@@ -34,6 +36,80 @@ func TestSort(t *testing.T) {
 	})
 }
 
+func TestSortStable(t *testing.T) {
+	v100a, _ := Parse("1.0.0+a")
+	v100b, _ := Parse("1.0.0+b")
+	v001, _ := Parse("0.0.1")
+	versions := []Version{v100a, v001, v100b}
+	SortStable(versions)
+
+	correct := []Version{v001, v100a, v100b}
+	if !reflect.DeepEqual(versions, correct) {
+		t.Fatalf("SortStable returned wrong order: %s", versions)
+	}
+}
+
+func TestSortDesc(t *testing.T) {
+	v100, _ := Parse("1.0.0")
+	v010, _ := Parse("0.1.0")
+	v001, _ := Parse("0.0.1")
+	versions := []Version{v010, v100, v001}
+	SortDesc(versions)
+
+	correct := []Version{v100, v010, v001}
+	if !reflect.DeepEqual(versions, correct) {
+		t.Fatalf("SortDesc returned wrong order: %s", versions)
+	}
+}
+
+type taggedVersion struct {
+	name    string
+	version Version
+}
+
+func TestSortFunc(t *testing.T) {
+	v100, _ := Parse("1.0.0")
+	v010, _ := Parse("0.1.0")
+	v001, _ := Parse("0.0.1")
+	items := []taggedVersion{
+		{name: "b", version: v010},
+		{name: "c", version: v100},
+		{name: "a", version: v001},
+	}
+
+	SortFunc(items, func(t taggedVersion) Version { return t.version })
+
+	names := make([]string, len(items))
+	for i, item := range items {
+		names[i] = item.name
+	}
+	if !reflect.DeepEqual(names, []string{"a", "b", "c"}) {
+		t.Fatalf("SortFunc returned wrong order: %v", names)
+	}
+}
+
+func TestSortSliceByVersionString(t *testing.T) {
+	t.Run("sorts by parsed version string", func(t *testing.T) {
+		items := []string{"1.0.0", "0.1.0", "0.0.1"}
+		if err := SortSliceByVersionString(items, func(s string) string { return s }); err != nil {
+			t.Fatalf("SortSliceByVersionString returned error: %v", err)
+		}
+		if !reflect.DeepEqual(items, []string{"0.0.1", "0.1.0", "1.0.0"}) {
+			t.Fatalf("SortSliceByVersionString returned wrong order: %v", items)
+		}
+	})
+
+	t.Run("returns parse errors and leaves items unsorted", func(t *testing.T) {
+		items := []string{"1.0.0", "not-a-version"}
+		if err := SortSliceByVersionString(items, func(s string) string { return s }); err == nil {
+			t.Fatalf("expected SortSliceByVersionString to return an error")
+		}
+		if !reflect.DeepEqual(items, []string{"1.0.0", "not-a-version"}) {
+			t.Fatalf("SortSliceByVersionString modified items on error: %v", items)
+		}
+	})
+}
+
 func BenchmarkSort(b *testing.B) {
 	v100, _ := Parse("1.0.0")
 	v010, _ := Parse("0.1.0")
@@ -44,3 +120,35 @@ func BenchmarkSort(b *testing.B) {
 		Sort([]Version{v010, v100, v001})
 	}
 }
+
+func randomVersions(n int) []Version {
+	r := rand.New(rand.NewSource(1))
+	versions := make([]Version, n)
+	for i := range versions {
+		v, _ := Parse(fmt.Sprintf("%d.%d.%d", r.Intn(100), r.Intn(100), r.Intn(100)))
+		versions[i] = v
+	}
+	return versions
+}
+
+func BenchmarkSort1k(b *testing.B) {
+	base := randomVersions(1000)
+	versions := make([]Version, len(base))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		copy(versions, base)
+		Sort(versions)
+	}
+}
+
+func BenchmarkSort10k(b *testing.B) {
+	base := randomVersions(10000)
+	versions := make([]Version, len(base))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		copy(versions, base)
+		Sort(versions)
+	}
+}